@@ -170,6 +170,13 @@ func createOIDCConfig(ctx context.Context, logger slog.Logger, vals *codersdk.De
 		groupAllowList[group] = true
 	}
 
+	// Claim values are trimmed before the mapping lookup, so trim the keys
+	// too or a padded key could never match.
+	groupMapping := make(map[string]string, len(vals.OIDC.GroupMapping.Value))
+	for idpGroup, coderGroup := range vals.OIDC.GroupMapping.Value {
+		groupMapping[strings.TrimSpace(idpGroup)] = coderGroup
+	}
+
 	return &coderd.OIDCConfig{
 		OAuth2Config: useCfg,
 		Provider:     oidcProvider,
@@ -190,7 +197,7 @@ func createOIDCConfig(ctx context.Context, logger slog.Logger, vals *codersdk.De
 		GroupFilter:         vals.OIDC.GroupRegexFilter.Value(),
 		GroupAllowList:      groupAllowList,
 		CreateMissingGroups: vals.OIDC.GroupAutoCreate.Value(),
-		GroupMapping:        vals.OIDC.GroupMapping.Value,
+		GroupMapping:        groupMapping,
 		UserRoleField:       vals.OIDC.UserRoleField.String(),
 		UserRoleMapping:     vals.OIDC.UserRoleMapping.Value,
 		UserRolesDefault:    vals.OIDC.UserRolesDefault.GetSlice(),
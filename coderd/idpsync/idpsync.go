@@ -2,7 +2,11 @@ package idpsync
 
 import (
 	"context"
+	"encoding/json"
+	"math"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -70,7 +74,63 @@ func NewAGPLSync(logger slog.Logger, settings SyncSettings) *AGPLIDPSync {
 	}
 }
 
-// ParseStringSliceClaim parses the claim for groups and roles, expected []string.
+// ParseGroupClaim parses the claim for groups. On top of everything
+// ParseStringSliceClaim accepts, it handles shapes only seen in group claims:
+//   - A map of group names to a membership flag, e.g. {"eng": true, "qa": false}.
+//     Only the keys set to true are returned, sorted so the result does not
+//     depend on map iteration order.
+//   - Numeric group IDs, e.g. [1024, 2048], returned as "1024" and "2048".
+func ParseGroupClaim(claim interface{}) ([]string, error) {
+	asArray, ok := claim.([]interface{})
+	if ok {
+		groups := make([]string, 0, len(asArray))
+		for i, item := range asArray {
+			switch v := item.(type) {
+			case string:
+				groups = append(groups, v)
+			case float64:
+				// Claims decoded from JSON hold numbers as float64, which is
+				// only exact for integers below 2^53. Anything else could
+				// silently turn into a different group ID.
+				if v != math.Trunc(v) || math.Abs(v) >= 1<<53 {
+					return nil, xerrors.Errorf("invalid claim type. Element %d expected an integer group ID, got: %v", i, v)
+				}
+				groups = append(groups, strconv.FormatInt(int64(v), 10))
+			case json.Number:
+				id, err := v.Int64()
+				if err != nil {
+					return nil, xerrors.Errorf("invalid claim type. Element %d expected an integer group ID, got: %q", i, v.String())
+				}
+				groups = append(groups, strconv.FormatInt(id, 10))
+			default:
+				return nil, xerrors.Errorf("invalid claim type. Element %d expected a string or number, got: %T", i, item)
+			}
+		}
+		return groups, nil
+	}
+
+	asMap, ok := claim.(map[string]interface{})
+	if !ok {
+		return ParseStringSliceClaim(claim)
+	}
+
+	groups := make([]string, 0, len(asMap))
+	for key, item := range asMap {
+		isMember, ok := item.(bool)
+		if !ok {
+			return nil, xerrors.Errorf("invalid claim type. Key %q expected a boolean, got: %T", key, item)
+		}
+		if isMember {
+			groups = append(groups, key)
+		}
+	}
+	slices.Sort(groups)
+	return groups, nil
+}
+
+// ParseStringSliceClaim parses the claim for groups, roles and organizations,
+// expected []string. Group claims should use ParseGroupClaim, which also
+// accepts shapes only seen in group claims.
 //
 // Some providers like ADFS return a single string instead of an array if there
 // is only 1 element. So this function handles the edge cases.
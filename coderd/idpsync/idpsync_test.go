@@ -107,6 +107,11 @@ func TestParseStringSliceClaim(t *testing.T) {
 			JSONClaim:     `["a", "b", 1]`,
 			ErrorExpected: true,
 		},
+		{
+			Name:          "JSONMap",
+			JSONClaim:     `{"a": true}`,
+			ErrorExpected: true,
+		},
 		{
 			Name:          "JSON_CSV",
 			JSONClaim:     `"a,b,c"`,
@@ -136,6 +141,128 @@ func TestParseStringSliceClaim(t *testing.T) {
 	}
 }
 
+func TestParseGroupClaim(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name          string
+		GoClaim       interface{}
+		JSONClaim     string
+		ErrorExpected bool
+		ExpectedSlice []string
+		// ExpectOrdered asserts the exact order of ExpectedSlice.
+		ExpectOrdered bool
+	}{
+		// Non map claims are handled by ParseStringSliceClaim.
+		{
+			Name:          "StringSlice",
+			GoClaim:       []string{"a", "b", "c"},
+			ExpectedSlice: []string{"a", "b", "c"},
+		},
+		{
+			Name:          "JSON_CSV",
+			JSONClaim:     `"a,b,c"`,
+			ErrorExpected: true,
+		},
+		// Numeric group IDs
+		{
+			Name:          "FloatSlice",
+			GoClaim:       []interface{}{float64(1024), float64(2048)},
+			ExpectedSlice: []string{"1024", "2048"},
+		},
+		{
+			Name:          "NumberSlice",
+			GoClaim:       []interface{}{json.Number("1024"), "a"},
+			ExpectedSlice: []string{"1024", "a"},
+		},
+		{
+			Name:          "JSONNumberSlice",
+			JSONClaim:     `[1024, 2048]`,
+			ExpectedSlice: []string{"1024", "2048"},
+		},
+		{
+			Name:          "JSONMixedSlice",
+			JSONClaim:     `["a", "b", 1]`,
+			ExpectedSlice: []string{"a", "b", "1"},
+		},
+		{
+			Name:          "JSONLargestExactInteger",
+			JSONClaim:     `[9007199254740991]`,
+			ExpectedSlice: []string{"9007199254740991"},
+		},
+		{
+			Name:          "JSONIntegerAboveFloatPrecision",
+			JSONClaim:     `[9007199254740993]`,
+			ErrorExpected: true,
+		},
+		{
+			Name:          "JSONFractionalNumber",
+			JSONClaim:     `[1.5]`,
+			ErrorExpected: true,
+		},
+		{
+			Name:          "FractionalJSONNumber",
+			GoClaim:       []interface{}{json.Number("1.5")},
+			ErrorExpected: true,
+		},
+		{
+			Name:          "JSONBoolInSlice",
+			JSONClaim:     `["a", "b", true]`,
+			ErrorExpected: true,
+		},
+		// Go map
+		{
+			Name:          "BoolMap",
+			GoClaim:       map[string]interface{}{"c": true, "b": false, "a": true},
+			ExpectedSlice: []string{"a", "c"},
+			ExpectOrdered: true,
+		},
+		{
+			Name:          "EmptyMap",
+			GoClaim:       map[string]interface{}{},
+			ExpectedSlice: []string{},
+			ExpectOrdered: true,
+		},
+		// JSON map
+		{
+			Name:          "JSONBoolMap",
+			JSONClaim:     `{"c": true, "b": false, "a": true}`,
+			ExpectedSlice: []string{"a", "c"},
+			ExpectOrdered: true,
+		},
+		{
+			Name:          "JSONStringInMap",
+			JSONClaim:     `{"a": true, "b": "yes"}`,
+			ErrorExpected: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if len(c.JSONClaim) > 0 {
+				require.Nil(t, c.GoClaim, "go claim should be nil if json set")
+				err := json.Unmarshal([]byte(c.JSONClaim), &c.GoClaim)
+				require.NoError(t, err, "unmarshal json claim")
+			}
+
+			found, err := idpsync.ParseGroupClaim(c.GoClaim)
+			if c.ErrorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if c.ExpectOrdered {
+				require.Equal(t, c.ExpectedSlice, found, "expected groups")
+			} else {
+				require.ElementsMatch(t, c.ExpectedSlice, found, "expected groups")
+			}
+		})
+	}
+}
+
 func TestIsHTTPError(t *testing.T) {
 	t.Parallel()
 
@@ -1107,7 +1107,7 @@ func (api *API) oidcGroups(ctx context.Context, mergedClaims map[string]interfac
 		usingGroups = true
 		groupsRaw, ok := mergedClaims[api.OIDCConfig.GroupField]
 		if ok {
-			parsedGroups, err := idpsync.ParseStringSliceClaim(groupsRaw)
+			parsedGroups, err := idpsync.ParseGroupClaim(groupsRaw)
 			if err != nil {
 				api.Logger.Debug(ctx, "groups field was an unknown type in oidc claims",
 					slog.F("type", fmt.Sprintf("%T", groupsRaw)),
@@ -1127,6 +1127,9 @@ func (api *API) oidcGroups(ctx context.Context, mergedClaims map[string]interfac
 			)
 
 			for _, group := range parsedGroups {
+				// Some IDPs pad group names with whitespace, which would
+				// otherwise never match a mapping or an existing group.
+				group = strings.TrimSpace(group)
 				if mappedGroup, ok := api.OIDCConfig.GroupMapping[group]; ok {
 					group = mappedGroup
 				}
@@ -2,6 +2,7 @@ package coderd_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"regexp"
 	"testing"
@@ -746,6 +747,72 @@ func TestGroupSync(t *testing.T) {
 				"groups": []string{"b", "c", "D"},
 			},
 		},
+		{
+			// Only the truthy keys of a map claim are used.
+			name: "MapClaimGroups",
+			modCfg: func(cfg *coderd.OIDCConfig) {
+				cfg.GroupMapping = map[string]string{
+					"eng": "b",
+					"qa":  "c",
+				}
+			},
+			initialOrgGroups:   []string{"a", "b", "c"},
+			initialUserGroups:  []string{"a"},
+			expectedUserGroups: []string{"b"},
+			expectedOrgGroups:  []string{"a", "b", "c"},
+			claims: jwt.MapClaims{
+				"groups": map[string]interface{}{"eng": true, "qa": false},
+			},
+		},
+		{
+			// Numeric group IDs can be mapped like any other group name.
+			name: "NumericClaimGroups",
+			modCfg: func(cfg *coderd.OIDCConfig) {
+				cfg.GroupMapping = map[string]string{
+					"1024": "eng",
+				}
+			},
+			initialOrgGroups:   []string{"eng"},
+			initialUserGroups:  []string{},
+			expectedUserGroups: []string{"eng"},
+			expectedOrgGroups:  []string{"eng"},
+			claims: jwt.MapClaims{
+				"groups": []interface{}{float64(1024)},
+			},
+		},
+		{
+			// The claims are encoded into the ID token, so a json.Number
+			// reaches coderd as a plain JSON number and maps the same way.
+			name: "JSONNumberClaimGroups",
+			modCfg: func(cfg *coderd.OIDCConfig) {
+				cfg.GroupMapping = map[string]string{
+					"2048": "ops",
+				}
+			},
+			initialOrgGroups:   []string{"eng", "ops"},
+			initialUserGroups:  []string{"eng"},
+			expectedUserGroups: []string{"ops"},
+			expectedOrgGroups:  []string{"eng", "ops"},
+			claims: jwt.MapClaims{
+				"groups": []interface{}{json.Number("2048")},
+			},
+		},
+		{
+			// Surrounding whitespace is trimmed before matching.
+			name: "TrimGroupWhitespace",
+			modCfg: func(cfg *coderd.OIDCConfig) {
+				cfg.GroupMapping = map[string]string{
+					"Engineering": "eng",
+				}
+			},
+			initialOrgGroups:   []string{"a", "b", "c", "d", "eng"},
+			initialUserGroups:  []string{"a"},
+			expectedUserGroups: []string{"b", "c", "d", "eng"},
+			expectedOrgGroups:  []string{"a", "b", "c", "d", "eng"},
+			claims: jwt.MapClaims{
+				"groups": []string{" b", "c ", "\td\n", "Engineering "},
+			},
+		},
 		{
 			// From a,c,b -> []
 			name: "RemoveAllGroups",